@@ -0,0 +1,63 @@
+package uuid
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+	mrand "math/rand"
+	"sync"
+)
+
+// Gen generates V4 UUIDs by reading from a pooled math/rand source rather
+// than crypto/rand. Each goroutine that calls NewV4 gets its own *rand.Rand
+// out of the pool, so there's no lock contention on the hot path, but the
+// output is only as unpredictable as math/rand: do not use Gen for anything
+// where an attacker guessing the ID would matter (session tokens, password
+// reset links, API keys). It's meant for high-volume, low-stakes IDs such
+// as request/transaction correlation IDs.
+type Gen struct {
+	pool sync.Pool
+}
+
+// NewGenWithReader returns a Gen whose per-goroutine math/rand sources are
+// seeded by reading from r. Pass crypto/rand.Reader (as the package-level
+// default does) or any other byte source.
+func NewGenWithReader(r io.Reader) *Gen {
+	return &Gen{
+		pool: sync.Pool{
+			New: func() interface{} {
+				var seed int64
+				if err := binary.Read(r, binary.LittleEndian, &seed); err != nil {
+					seed = 1
+				}
+				return mrand.New(mrand.NewSource(seed))
+			},
+		},
+	}
+}
+
+// NewV4 returns a V4 UUID generated from g's math/rand pool. Unlike the
+// package-level NewV4, it never fails.
+func (g *Gen) NewV4() UUID {
+	rng := g.pool.Get().(*mrand.Rand)
+	var u UUID
+	_, _ = rng.Read(u[:])
+	g.pool.Put(rng)
+
+	u[6] = (u[6] & 0x0f) | 0x40
+	u[8] = (u[8] & 0x3f) | 0x80
+	return u
+}
+
+// defaultGen seeds each math/rand source from crypto/rand when its pool
+// creates that source, but every UUID it mints draws its bytes from
+// math/rand. See Gen for the security trade-off this implies.
+var defaultGen = NewGenWithReader(rand.Reader)
+
+// NewV4Fast returns a V4 UUID using the package's default Gen. It trades
+// cryptographic unpredictability for throughput: prefer it over NewV4 only
+// for hot paths like request/transaction IDs where the value never needs to
+// resist guessing.
+func NewV4Fast() UUID {
+	return defaultGen.NewV4()
+}
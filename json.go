@@ -0,0 +1,74 @@
+package uuid
+
+import (
+	"database/sql/driver"
+)
+
+// MarshalJSON implements the json.Marshaler interface. It emits JSON null
+// for the zero UUID, mirroring the SQL NULL that Value already returns for
+// Zero, so a zero UUID round-trips the same way through both encodings.
+func (u UUID) MarshalJSON() ([]byte, error) {
+	if u.IsZero() {
+		return []byte("null"), nil
+	}
+	return []byte(`"` + u.String() + `"`), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It accepts JSON
+// null as the zero UUID.
+func (u *UUID) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*u = Zero
+		return nil
+	}
+	if len(data) >= 2 && data[0] == '"' && data[len(data)-1] == '"' {
+		data = data[1 : len(data)-1]
+	}
+	return u.UnmarshalText(data)
+}
+
+// NullUUID represents a UUID that may be absent, similar to sql.NullString.
+// Unlike UUID's own Value/Scan/MarshalJSON, which treat Zero itself as the
+// stand-in for absence, NullUUID lets Zero be a legitimate, present value by
+// tracking presence in Valid separately.
+type NullUUID struct {
+	UUID  UUID
+	Valid bool
+}
+
+// Value implements the driver.Valuer interface.
+func (n NullUUID) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.UUID.String(), nil
+}
+
+// Scan implements the sql.Scanner interface.
+func (n *NullUUID) Scan(src interface{}) error {
+	if src == nil {
+		n.UUID, n.Valid = Zero, false
+		return nil
+	}
+	n.Valid = true
+	return n.UUID.Scan(src)
+}
+
+// MarshalJSON implements the json.Marshaler interface. A valid Zero is
+// emitted as a string, not null.
+func (n NullUUID) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return []byte(`"` + n.UUID.String() + `"`), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (n *NullUUID) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		n.UUID, n.Valid = Zero, false
+		return nil
+	}
+	n.Valid = true
+	return n.UUID.UnmarshalJSON(data)
+}
@@ -0,0 +1,84 @@
+package uuid
+
+import (
+	"encoding/binary"
+	"time"
+)
+
+// UUID variants, per RFC 9562 section 4.1.
+const (
+	VariantNCS byte = iota
+	VariantRFC9562
+	VariantMicrosoft
+	VariantFuture
+)
+
+// gregorianToUnixSeconds is the number of seconds between 1582-10-15 (the
+// start of the Gregorian calendar, the epoch V1 and V6 timestamps are
+// counted from in 100ns intervals) and the Unix epoch.
+const gregorianToUnixSeconds = 12219292800
+
+// Version returns the UUID version, held in the top 4 bits of byte 6.
+func (u UUID) Version() byte {
+	return u[6] >> 4
+}
+
+// Variant returns the UUID variant, held in the top bits of byte 8.
+func (u UUID) Variant() byte {
+	switch {
+	case u[8]&0xc0 == 0x80:
+		return VariantRFC9562
+	case u[8]&0xe0 == 0xc0:
+		return VariantMicrosoft
+	case u[8]&0xe0 == 0xe0:
+		return VariantFuture
+	default:
+		return VariantNCS
+	}
+}
+
+// Time returns the timestamp embedded in a V1, V6, or V7 UUID. It returns
+// ok=false for any other version, which carries no embedded time.
+func (u UUID) Time() (t time.Time, ok bool) {
+	switch u.Version() {
+	case 1:
+		timeLow := uint64(binary.BigEndian.Uint32(u[0:4]))
+		timeMid := uint64(binary.BigEndian.Uint16(u[4:6]))
+		timeHi := uint64(binary.BigEndian.Uint16(u[6:8])) & 0x0fff
+		return gregorianTime(timeLow | timeMid<<32 | timeHi<<48), true
+	case 6:
+		timeHigh := uint64(binary.BigEndian.Uint32(u[0:4]))
+		timeMid := uint64(binary.BigEndian.Uint16(u[4:6]))
+		timeLow := uint64(binary.BigEndian.Uint16(u[6:8])) & 0x0fff
+		return gregorianTime(timeHigh<<28 | timeMid<<12 | timeLow), true
+	case 7:
+		ms := uint64(binary.BigEndian.Uint16(u[4:6])) | uint64(binary.BigEndian.Uint32(u[0:4]))<<16
+		return time.UnixMilli(int64(ms)).UTC(), true
+	default:
+		return time.Time{}, false
+	}
+}
+
+// gregorianTime converts ticks, a count of 100ns intervals since the
+// Gregorian epoch, to a time.Time. It converts via time.Unix's separate
+// seconds/nanoseconds rather than a single time.Duration, since a Duration
+// is a count of nanoseconds and overflows int64 for any present-day tick
+// count at this resolution.
+func gregorianTime(ticks uint64) time.Time {
+	sec := int64(ticks/1e7) - gregorianToUnixSeconds
+	nsec := int64(ticks%1e7) * 100
+	return time.Unix(sec, nsec).UTC()
+}
+
+// Node returns the 6-byte node identifier embedded in a V1 or V6 UUID. It
+// returns ok=false for any other version, which carries no node field.
+func (u UUID) Node() (node []byte, ok bool) {
+	switch u.Version() {
+	case 1, 6:
+		node = make([]byte, 6)
+		copy(node, u[10:16])
+		return node, true
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,30 @@
+package uuid
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimeRoundTrip(t *testing.T) {
+	now := time.Now().UTC()
+
+	for _, tc := range []struct {
+		name string
+		gen  func() (UUID, error)
+	}{
+		{"v1", NewV1},
+		{"v6", NewV6},
+	} {
+		u, err := tc.gen()
+		if err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		got, ok := u.Time()
+		if !ok {
+			t.Fatalf("%s: Time() returned ok=false", tc.name)
+		}
+		if got.Before(now.Add(-time.Minute)) || got.After(now.Add(time.Minute)) {
+			t.Fatalf("%s: Time() = %v, want roughly %v", tc.name, got, now)
+		}
+	}
+}
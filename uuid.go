@@ -46,13 +46,23 @@ func (u *UUID) UnmarshalBinary(data []byte) (err error) {
 }
 
 func (u *UUID) UnmarshalText(text []byte) (err error) {
-	if len(text) < 32 {
+	text = stripUUIDDecoration(text)
+	switch len(text) {
+	case 32:
+	case 36:
+		if text[8] != '-' || text[13] != '-' || text[18] != '-' || text[23] != '-' {
+			err = fmt.Errorf("uuid: invalid UUID string: %s", text)
+			return
+		}
+		text = bytes.Replace(text, []byte("-"), []byte(""), -1)
+		if len(text) != 32 {
+			err = fmt.Errorf("uuid: invalid UUID string: %s", text)
+			return
+		}
+	default:
 		err = fmt.Errorf("uuid: invalid UUID string: %s", text)
 		return
 	}
-	if len(text) == 36 {
-		text = bytes.Replace(text, []byte("-"), []byte(""), -1)
-	}
 	_, err = hex.Decode(u[:], text)
 	if err != nil {
 		return
@@ -60,6 +70,53 @@ func (u *UUID) UnmarshalText(text []byte) (err error) {
 	return
 }
 
+// stripUUIDDecoration strips the "urn:uuid:" prefix and surrounding braces
+// that the canonical RFC 4122 string forms allow, leaving either a 32-char
+// hex string or a 36-char hyphenated one.
+func stripUUIDDecoration(text []byte) []byte {
+	text = bytes.TrimPrefix(text, []byte("urn:uuid:"))
+	if len(text) == 38 && text[0] == '{' && text[len(text)-1] == '}' {
+		text = text[1 : len(text)-1]
+	}
+	return text
+}
+
+// Parse accepts the same forms as FromString: 32-char hex, or 36-char
+// hyphenated with hyphens at exactly positions 8, 13, 18 and 23, either of
+// them optionally prefixed with "urn:uuid:" or (hyphenated only) wrapped in
+// braces. Any other hyphen placement is rejected. Its error message quotes
+// input as given rather than with the decoration stripped.
+func Parse(input string) (u UUID, err error) {
+	text := stripUUIDDecoration([]byte(input))
+	switch len(text) {
+	case 32:
+	case 36:
+		if text[8] != '-' || text[13] != '-' || text[18] != '-' || text[23] != '-' {
+			err = fmt.Errorf("uuid: invalid UUID string: %s", input)
+			return
+		}
+		text = bytes.Replace(text, []byte("-"), []byte(""), -1)
+		if len(text) != 32 {
+			err = fmt.Errorf("uuid: invalid UUID string: %s", input)
+			return
+		}
+	default:
+		err = fmt.Errorf("uuid: invalid UUID string: %s", input)
+		return
+	}
+	_, err = hex.Decode(u[:], text)
+	return
+}
+
+// MustParse is like Parse but panics if input cannot be parsed.
+func MustParse(input string) UUID {
+	u, err := Parse(input)
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
+
 func (u UUID) MarshalText() (data []byte, err error) {
 	data = []byte(u.String())
 	return
@@ -116,6 +173,28 @@ func NewV1() (UUID, error) {
 	return u, nil
 }
 
+func NewV6() (UUID, error) {
+	var u UUID
+	n, err := uuid.NewV6()
+	if err != nil {
+		return u, err
+	}
+	u = UUID(n)
+	return u, nil
+}
+
+// NewV8 returns a V8 UUID for the given 16 bytes of caller-supplied,
+// free-form payload. All bits of data are taken verbatim except for the
+// version nibble (top 4 bits of byte 6) and variant bits (top 2 bits of
+// byte 8), which are overwritten per RFC 9562 — so the caller controls the
+// full 122 bits of free-form payload RFC 9562 allows for V8.
+func NewV8(data [16]byte) (UUID, error) {
+	u := UUID(data)
+	u[6] = (u[6] & 0x0f) | 0x80
+	u[8] = (u[8] & 0x3f) | 0x80
+	return u, nil
+}
+
 func NewV7() (UUID, error) {
 	var u UUID
 	n, err := uuid.NewV7()
@@ -0,0 +1,16 @@
+package uuid
+
+import "testing"
+
+func TestFromStringRejectsMisplacedHyphens(t *testing.T) {
+	cases := []string{
+		"aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", // 36 hex chars, no hyphens
+		"aa-aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", // hyphen in the wrong place
+		"aa-aaaaa-aaaa-aaaa-aaaa-aaaaaa-aaaaa",  // canonical hyphen positions plus 2 extra
+	}
+	for _, c := range cases {
+		if _, err := FromString(c); err == nil {
+			t.Errorf("FromString(%q): expected error, got nil", c)
+		}
+	}
+}